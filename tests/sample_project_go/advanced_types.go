@@ -148,6 +148,7 @@ type User struct {
 	Age       int    `json:"age,omitempty" db:"age"`
 	IsActive  bool   `json:"is_active" db:"is_active"`
 	Role      string `json:"role" db:"role" default:"user"`
+	Password  string `json:"-" db:"password" validate:"required,min=8"`
 }
 
 // AnonymousStruct demonstrates anonymous structs
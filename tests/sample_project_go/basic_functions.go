@@ -4,6 +4,7 @@ package main
 import (
 	"fmt"
 	"math"
+	"strings"
 )
 
 // SimpleFunction is a basic function with single return
@@ -47,6 +48,24 @@ func FunctionReturningFunction(multiplier int) func(int) int {
 	}
 }
 
+// CounterClosure captures count by reference across calls
+func CounterClosure() func() int {
+	count := 0
+	return func() int {
+		count++
+		return count
+	}
+}
+
+// ApplyToAll applies fn to every word
+func ApplyToAll(words []string, fn func(string) string) []string {
+	result := make([]string, len(words))
+	for i, w := range words {
+		result[i] = fn(w)
+	}
+	return result
+}
+
 // RecursiveFunction demonstrates recursion
 func RecursiveFunction(n int) int {
 	if n <= 1 {
@@ -78,6 +97,30 @@ func PanicRecoverExample(shouldPanic bool) (result string) {
 	return
 }
 
+// namedRecover is a named-function defer that recovers from a panic
+func namedRecover(result *string) {
+	if r := recover(); r != nil {
+		*result = fmt.Sprintf("named recover got: %v", r)
+	}
+}
+
+// PanicRecoverNamedDefer recovers through a named deferred function
+func PanicRecoverNamedDefer(shouldPanic bool) (result string) {
+	defer namedRecover(&result)
+
+	if shouldPanic {
+		panic("boom")
+	}
+
+	result = "no panic"
+	return
+}
+
+// RecoverOutsideDefer calls recover() outside a defer, so it returns nil
+func RecoverOutsideDefer() interface{} {
+	return recover()
+}
+
 // MathHelper calls external package function
 func MathHelper(x float64) float64 {
 	return math.Sqrt(x)
@@ -95,5 +138,7 @@ func main() {
 	
 	doubler := FunctionReturningFunction(2)
 	fmt.Println(doubler(5))
+
+	fmt.Println(ApplyToAll([]string{"go", "is", "fun"}, strings.ToUpper))
 }
 
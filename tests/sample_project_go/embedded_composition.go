@@ -105,6 +105,12 @@ func (cl ConsoleLogger) Log(message string) {
 	fmt.Printf("[%s] %s\n", cl.Prefix, message)
 }
 
+// NullLogger implements Logger by discarding every message
+type NullLogger struct{}
+
+// Log implements Logger interface by doing nothing
+func (NullLogger) Log(message string) {}
+
 // Service embeds a logger
 type Service struct {
 	Logger
@@ -288,6 +294,32 @@ func (c ConflictExample) AccessConflict() string {
 		c.Base.Name, c.Extended.Name)
 }
 
+// DeepBase is embedded two levels deep to exercise promotion depth
+type DeepBase struct {
+	Label string
+}
+
+// Identify returns the deep base's label
+func (d DeepBase) Identify() string {
+	return "DeepBase:" + d.Label
+}
+
+// MidLevel embeds DeepBase, one level removed from the eventual top type
+type MidLevel struct {
+	DeepBase
+}
+
+// TopLevel embeds MidLevel and shadows its promoted Identify method
+type TopLevel struct {
+	MidLevel
+	Label string
+}
+
+// Identify shadows the depth-2 promoted DeepBase.Identify
+func (t TopLevel) Identify() string {
+	return "TopLevel:" + t.Label
+}
+
 func demonstrateComposition() {
 	// Basic embedding
 	extended := Extended{
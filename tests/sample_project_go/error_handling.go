@@ -268,6 +268,61 @@ func validateEmail(data map[string]interface{}) error {
 	return nil
 }
 
+// WrapTwice wraps an already-wrapped error a second time
+func WrapTwice(filename string) error {
+	err := ErrorWrapping(filename)
+	if err != nil {
+		return fmt.Errorf("wrap twice: %w", err)
+	}
+	return nil
+}
+
+// SwallowedOpenError discards the error returned by os.Open
+func SwallowedOpenError(filename string) {
+	file, _ := os.Open(filename)
+	if file != nil {
+		file.Close()
+	}
+}
+
+// AlwaysDiscarded returns an error that every call site below ignores.
+func AlwaysDiscarded(path string) error {
+	_, err := os.Open(path)
+	return err
+}
+
+// callSitesDiscardError discards AlwaysDiscarded's result twice over
+func callSitesDiscardError() {
+	_ = AlwaysDiscarded("ignored.txt")
+	AlwaysDiscarded("also-ignored.txt")
+}
+
+// ErrorsNewInLoop allocates a new error on every iteration
+func ErrorsNewInLoop(items []string) []error {
+	var errs []error
+	for _, item := range items {
+		if item == "" {
+			errs = append(errs, errors.New("item cannot be empty"))
+		}
+	}
+	return errs
+}
+
+// WrapWithoutVerb formats err with %v instead of %w
+func WrapWithoutVerb(filename string) error {
+	_, err := os.Open(filename)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %v", filename, err)
+	}
+	return nil
+}
+
+// UnrecoveredPanic panics with no deferred recover anywhere in its body,
+// the panic-in-library smell: a caller has no way to turn this into an error.
+func UnrecoveredPanic(reason string) {
+	panic(reason)
+}
+
 func demonstrateErrors() {
 	// Test basic error
 	_, err := BasicErrorReturn(-1)
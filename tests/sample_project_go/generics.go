@@ -3,6 +3,8 @@ package main
 
 import (
 	"fmt"
+	"sync"
+
 	"golang.org/x/exp/constraints"
 )
 
@@ -245,6 +247,31 @@ func (c *Cache[K, V]) Keys() []K {
 	return keys
 }
 
+// SafeCache wraps Cache with an RWMutex for concurrent use
+type SafeCache[K comparable, V any] struct {
+	mu    sync.RWMutex
+	cache Cache[K, V]
+}
+
+// NewSafeCache creates a concurrency-safe cache
+func NewSafeCache[K comparable, V any]() *SafeCache[K, V] {
+	return &SafeCache[K, V]{cache: *NewCache[K, V]()}
+}
+
+// Set safely stores a value
+func (c *SafeCache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Set(key, value)
+}
+
+// Get safely retrieves a value
+func (c *SafeCache[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cache.Get(key)
+}
+
 // FindFirst returns the first item matching the predicate
 func FindFirst[T any](items []T, predicate func(T) bool) (T, bool) {
 	for _, item := range items {
@@ -266,6 +293,19 @@ func GroupBy[T any, K comparable](items []T, keyFn func(T) K) map[K][]T {
 	return result
 }
 
+// ID is a custom integer type used to exercise approximation constraints
+type ID int
+
+// Identifiable constrains to any type with an underlying int or int64
+type Identifiable interface {
+	~int | ~int64
+}
+
+// NormalizeID widens any Identifiable value to int64
+func NormalizeID[T Identifiable](id T) int64 {
+	return int64(id)
+}
+
 func demonstrateGenerics() {
 	// Generic functions
 	fmt.Println("Max:", GenericMax(10, 20))
@@ -233,6 +233,30 @@ func FanIn(inputs ...<-chan int) <-chan int {
 	return output
 }
 
+// PubSub demonstrates a simple publish-subscribe pattern
+type PubSub struct {
+	mu   sync.Mutex
+	subs []chan string
+}
+
+// Subscribe registers a new subscriber and returns its channel
+func (ps *PubSub) Subscribe() <-chan string {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ch := make(chan string, 1)
+	ps.subs = append(ps.subs, ch)
+	return ch
+}
+
+// Publish broadcasts a message to every current subscriber
+func (ps *PubSub) Publish(message string) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	for _, ch := range ps.subs {
+		ch <- message
+	}
+}
+
 func demonstrateConcurrency() {
 	// Simple goroutines
 	for i := 1; i <= 3; i++ {
@@ -156,6 +156,32 @@ type ComplexShape interface {
 	Volume() float64
 }
 
+// Sphere is the only concrete type in this file that satisfies ComplexShape
+type Sphere struct {
+	Radius float64
+	Name   string
+}
+
+// Area implements Shape interface for Sphere
+func (s Sphere) Area() float64 {
+	return 4 * math.Pi * s.Radius * s.Radius
+}
+
+// Perimeter implements Shape interface for Sphere
+func (s Sphere) Perimeter() float64 {
+	return 2 * math.Pi * s.Radius
+}
+
+// GetName implements Named interface for Sphere
+func (s Sphere) GetName() string {
+	return s.Name
+}
+
+// Volume implements the extra ComplexShape method
+func (s Sphere) Volume() float64 {
+	return (4.0 / 3.0) * math.Pi * s.Radius * s.Radius * s.Radius
+}
+
 // ProcessShapes processes multiple shapes
 func ProcessShapes(shapes ...Shape) map[string]float64 {
 	results := make(map[string]float64)
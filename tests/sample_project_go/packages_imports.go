@@ -272,6 +272,11 @@ func init() {
 	fmt.Println("Package initialized")
 }
 
+// A second init in the same file - runs after the first
+func init() {
+	config["region"] = "us-east-1"
+}
+
 // GetConfig returns the config
 func GetConfig(key string) string {
 	return config[key]
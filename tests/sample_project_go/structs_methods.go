@@ -111,6 +111,27 @@ func (p Person) CompareAge(other Person) string {
 	return fmt.Sprintf("%s and %s are the same age", p.Name, other.Name)
 }
 
+// Manager embeds Employee, giving it a two-level promotion chain
+type Manager struct {
+	Employee
+	Reports int
+}
+
+// ListDirectReports returns how many people report to this manager
+func (m Manager) ListDirectReports() int {
+	return m.Reports
+}
+
+// BuggyRename mutates a value receiver; the change is lost on return
+func (p Person) BuggyRename(newName string) {
+	p.Name = newName
+}
+
+// GetAge uses a pointer receiver despite never mutating the receiver
+func (p *Person) GetAge() int {
+	return p.Age
+}
+
 func demonstrateStructs() {
 	person := NewPerson("Alice", 30)
 	fmt.Println(person.Greet())
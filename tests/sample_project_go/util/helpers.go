@@ -147,6 +147,11 @@ func NewLogger(prefix string) *Logger {
 	return &Logger{Prefix: prefix}
 }
 
+// String implements fmt.Stringer for Logger
+func (l Logger) String() string {
+	return fmt.Sprintf("Logger(%s)", l.Prefix)
+}
+
 // Info logs info message
 func (l Logger) Info(message string) {
 	fmt.Printf("[%s] INFO: %s\n", l.Prefix, message)
@@ -162,6 +167,14 @@ func (l Logger) Debug(message string) {
 	fmt.Printf("[%s] DEBUG: %s\n", l.Prefix, message)
 }
 
+// DrainChannel has a single-case select simplifiable to a plain receive
+func DrainChannel(ch chan int) int {
+	select {
+	case v := <-ch:
+		return v
+	}
+}
+
 // Helper functions (package-level)
 
 // Capitalize capitalizes first letter of string